@@ -0,0 +1,78 @@
+// Command cli_portfolio_ssh serves the portfolio over SSH using Wish, so
+// it can be experienced with e.g. `ssh portfolio.milanhommet.dev` without
+// installing anything. Each connecting session gets its own fresh
+// RootModel; window resizes are forwarded automatically by the Bubble
+// Tea middleware, and the "o" key copies links via OSC52 instead of
+// opening a (nonexistent) local browser.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	bm "github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+	"github.com/mhommet/cli_portfolio/components"
+)
+
+func main() {
+	host := flag.String("host", "0.0.0.0", "address to bind the SSH server to")
+	port := flag.String("port", "23234", "port to bind the SSH server to")
+	hostKeyPath := flag.String("host-key", ".ssh/cli_portfolio_ed25519", "path to the server's SSH host key")
+	flag.Parse()
+
+	srv, err := wish.NewServer(
+		wish.WithAddress(net.JoinHostPort(*host, *port)),
+		wish.WithHostKeyPath(*hostKeyPath),
+		wish.WithMiddleware(
+			bm.Middleware(teaHandler),
+			logging.Middleware(),
+		),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	log.Printf("Starting SSH server on %s", net.JoinHostPort(*host, *port))
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+			log.Fatalln(err)
+		}
+	}()
+
+	<-done
+	log.Println("Stopping SSH server")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// teaHandler builds a fresh portfolio model for each incoming session.
+func teaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+	_, _, active := s.Pty()
+	if !active {
+		wish.Fatalln(s, "no active terminal, skipping")
+		return nil, nil
+	}
+
+	m := components.NewRootModelForSSH(s)
+	return m, []tea.ProgramOption{
+		tea.WithAltScreen(),
+		tea.WithMouseCellMotion(),
+	}
+}
@@ -0,0 +1,63 @@
+package components
+
+import (
+	"log"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+	"github.com/mhommet/cli_portfolio/internal/config"
+)
+
+// configReloadedMsg is sent into the Bubble Tea Update loop whenever
+// portfolio.yaml changes on disk.
+type configReloadedMsg struct{ cfg *config.Config }
+
+// watchConfig starts an fsnotify watch on path's parent directory and
+// pushes a configReloadedMsg on ch every time the file is written. It
+// watches the directory rather than the file itself because editors
+// typically "save" by writing a temp file and renaming it over the
+// original, which replaces the inode fsnotify is watching; a direct
+// file watch would silently die after the first such save. Reload
+// errors are logged and skipped so a momentarily invalid save (e.g.
+// mid-write) doesn't crash the TUI.
+func watchConfig(path string, ch chan<- configReloadedMsg) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("config watcher: %v", err)
+		return
+	}
+
+	dir := filepath.Dir(path)
+	name := filepath.Base(path)
+
+	if err := watcher.Add(dir); err != nil {
+		log.Printf("config watcher: watch %s: %v", dir, err)
+		return
+	}
+
+	for event := range watcher.Events {
+		if filepath.Base(event.Name) != name {
+			continue
+		}
+		if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+			continue
+		}
+		cfg, err := config.Load(path)
+		if err != nil {
+			log.Printf("config watcher: reload %s: %v", path, err)
+			continue
+		}
+		ch <- configReloadedMsg{cfg: cfg}
+	}
+}
+
+// waitForConfigReload turns the watcher's channel into a tea.Cmd, the
+// pattern Bubble Tea uses for any long-lived external event source: it
+// blocks on the channel and must be re-issued after every message so the
+// Update loop keeps listening.
+func waitForConfigReload(ch <-chan configReloadedMsg) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
@@ -0,0 +1,54 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+// ErrorScene shows a fatal, app-level error (e.g. the config file could
+// not be parsed at startup). Scene-local failures like a failed GitHub
+// fetch are shown inline by the scene that triggered them instead.
+type ErrorScene struct {
+	message string
+	theme   theme.Theme
+	width   int
+	height  int
+}
+
+// NewErrorScene builds the Error scene.
+func NewErrorScene(message string, t theme.Theme) *ErrorScene {
+	return &ErrorScene{message: message, theme: t}
+}
+
+func (s *ErrorScene) Init() tea.Cmd { return nil }
+
+func (s *ErrorScene) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		s.height = msg.Height
+	case themeChangedMsg:
+		s.theme = msg.theme
+	case tea.KeyMsg:
+		if msg.String() == "b" {
+			return s, PopScene()
+		}
+	}
+	return s, nil
+}
+
+func (s *ErrorScene) View() string {
+	style := lipgloss.NewStyle().Align(lipgloss.Center).Width(s.width - 10).Foreground(s.theme.ErrorColor)
+	return style.Render("\nError:\n" + s.message + "\n")
+}
+
+func (s *ErrorScene) Title() string { return "Error" }
+
+// CapturesKeys is always false: the Error scene has no text input.
+func (s *ErrorScene) CapturesKeys() bool { return false }
+
+func (s *ErrorScene) Keys() []key.Binding {
+	return []key.Binding{backKey, quitKey}
+}
@@ -0,0 +1,196 @@
+package components
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	htmltomarkdown "github.com/JohannesKaufmann/html-to-markdown/v2"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+)
+
+// githubRepo mirrors the subset of GitHub's "list repositories for a
+// user" response we render: https://docs.github.com/en/rest/repos/repos
+type githubRepo struct {
+	Name            string   `json:"name"`
+	FullName        string   `json:"full_name"`
+	Description     string   `json:"description"`
+	HTMLURL         string   `json:"html_url"`
+	StargazersCount int      `json:"stargazers_count"`
+	Language        string   `json:"language"`
+	Topics          []string `json:"topics"`
+	UpdatedAt       string   `json:"updated_at"`
+	Fork            bool     `json:"fork"`
+	Archived        bool     `json:"archived"`
+}
+
+// githubReadme mirrors the "get a repository README" response.
+type githubReadme struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type repoMsg struct {
+	repos     []githubRepo
+	fromCache bool
+}
+type readmeMsg struct {
+	repo     string
+	rendered string
+}
+type errMsg struct{ err error }
+type openURLMsg string
+
+// fetchGitHubRepos lists mhommet's public repositories, newest updated
+// first, for the Projects scene. It honors the on-disk cache's ETag so a
+// re-launch with nothing new is a single 304 instead of a full payload,
+// and falls back to the cache entirely if the request fails (offline).
+func fetchGitHubRepos() tea.Cmd {
+	return func() tea.Msg {
+		cache := loadRepoCache()
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.github.com/users/mhommet/repos?sort=updated&per_page=100", nil)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		if cache != nil && cache.ETag != "" {
+			req.Header.Set("If-None-Match", cache.ETag)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			if cache != nil {
+				return repoMsg{repos: cache.Repos, fromCache: true}
+			}
+			return errMsg{err: err}
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode == http.StatusNotModified && cache != nil {
+			return repoMsg{repos: cache.Repos, fromCache: true}
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			if cache != nil {
+				return repoMsg{repos: cache.Repos, fromCache: true}
+			}
+			return errMsg{err: fmt.Errorf("failed to fetch repositories: %s", resp.Status)}
+		}
+
+		var repos []githubRepo
+		if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
+			return errMsg{err: err}
+		}
+
+		if err := saveRepoCache(repoCache{ETag: resp.Header.Get("ETag"), Repos: repos}); err != nil {
+			log.Printf("repo cache: %v", err)
+		}
+
+		return repoMsg{repos: repos}
+	}
+}
+
+// fetchReadme fetches and renders the README of owner/repo, converting
+// any raw HTML to Markdown first so Glamour has something it can render.
+func fetchReadme(owner, repo string) tea.Cmd {
+	return func() tea.Msg {
+		url := fmt.Sprintf("https://api.github.com/repos/%s/%s/readme", owner, repo)
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return errMsg{err: err}
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return errMsg{err: fmt.Errorf("failed to fetch README for %s/%s: %s", owner, repo, resp.Status)}
+		}
+
+		var gh githubReadme
+		if err := json.NewDecoder(resp.Body).Decode(&gh); err != nil {
+			return errMsg{err: err}
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(gh.Content, "\n", ""))
+		if err != nil {
+			return errMsg{err: fmt.Errorf("decode README for %s/%s: %w", owner, repo, err)}
+		}
+
+		markdown := string(raw)
+		if looksLikeHTML(markdown) {
+			if converted, err := htmltomarkdown.ConvertString(markdown); err == nil {
+				markdown = converted
+			}
+		}
+
+		rendered, err := glamour.Render(markdown, "dark")
+		if err != nil {
+			return errMsg{err: fmt.Errorf("render README for %s/%s: %w", owner, repo, err)}
+		}
+
+		return readmeMsg{repo: owner + "/" + repo, rendered: rendered}
+	}
+}
+
+// looksLikeHTML is a cheap heuristic: READMEs that are plain Markdown
+// rarely open with a tag, while HTML-only READMEs (rare, but they exist)
+// usually start with one.
+func looksLikeHTML(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "<") && strings.Contains(s, ">")
+}
+
+// splitFullName splits a GitHub "owner/repo" full name into its parts.
+func splitFullName(fullName string) (owner, repo string, ok bool) {
+	parts := strings.SplitN(fullName, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// openBrowser opens url in the user's default browser.
+func openBrowser(url string) error {
+	var cmd string
+	var args []string
+
+	switch runtime.GOOS {
+	case "windows":
+		cmd = "cmd"
+		args = []string{"/c", "start"}
+	case "darwin":
+		cmd = "open"
+	default: // "linux", "freebsd", etc.
+		cmd = "xdg-open"
+	}
+	args = append(args, url)
+	return exec.Command(cmd, args...).Start()
+}
+
+// OpenURLFunc surfaces a URL to the user in response to the "o" key in
+// the Projects and Project Info scenes. openURL, the default, opens it
+// in a local browser; cmd/cli_portfolio_ssh supplies an OSC52
+// clipboard-copy implementation instead, since the server has no
+// browser of its own.
+type OpenURLFunc func(url string) tea.Cmd
+
+// openURL is the default OpenURLFunc: it opens url in the browser.
+func openURL(url string) tea.Cmd {
+	return func() tea.Msg {
+		if err := openBrowser(url); err != nil {
+			return errMsg{err: err}
+		}
+		return openURLMsg(url)
+	}
+}
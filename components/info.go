@@ -0,0 +1,100 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/config"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+// InfoScene renders a simple block of centered text: About Me,
+// Education, Experience and Contact are all instances of it, built from
+// the loaded config by their own constructor below.
+type InfoScene struct {
+	title  string
+	body   func(cfg *config.Config) string
+	cfg    *config.Config
+	theme  theme.Theme
+	width  int
+	height int
+}
+
+func newInfoScene(title string, cfg *config.Config, t theme.Theme, body func(cfg *config.Config) string) *InfoScene {
+	return &InfoScene{title: title, cfg: cfg, theme: t, body: body}
+}
+
+// NewAboutScene shows the About Me section.
+func NewAboutScene(cfg *config.Config, t theme.Theme) *InfoScene {
+	return newInfoScene("About Me", cfg, t, func(cfg *config.Config) string {
+		text := "\nAbout Me:\n"
+		for _, p := range cfg.About {
+			text += p + "\n"
+		}
+		return text
+	})
+}
+
+// NewEducationScene shows the Education section.
+func NewEducationScene(cfg *config.Config, t theme.Theme) *InfoScene {
+	return newInfoScene("Education", cfg, t, func(cfg *config.Config) string {
+		text := "\nEducation:\n"
+		for _, e := range cfg.Education {
+			text += e.Years + " : " + e.Degree + "\n"
+		}
+		return text
+	})
+}
+
+// NewExperienceScene shows the Experience section.
+func NewExperienceScene(cfg *config.Config, t theme.Theme) *InfoScene {
+	return newInfoScene("Experience", cfg, t, func(cfg *config.Config) string {
+		text := "\nExperience:\n"
+		for _, e := range cfg.Experience {
+			text += e.Years + " : " + e.Role + "\n"
+		}
+		return text
+	})
+}
+
+// NewContactScene shows the Contact section.
+func NewContactScene(cfg *config.Config, t theme.Theme) *InfoScene {
+	return newInfoScene("Contact", cfg, t, func(cfg *config.Config) string {
+		return "\nContact:\n" +
+			"Email: " + cfg.Contact.Email + "\n" +
+			"LinkedIn: " + cfg.Contact.LinkedIn + "\n"
+	})
+}
+
+func (s *InfoScene) Init() tea.Cmd { return nil }
+
+func (s *InfoScene) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		s.height = msg.Height
+	case tea.KeyMsg:
+		if msg.String() == "b" {
+			return s, PopScene()
+		}
+	case configReloadedMsg:
+		s.cfg = msg.cfg
+	case themeChangedMsg:
+		s.theme = msg.theme
+	}
+	return s, nil
+}
+
+func (s *InfoScene) View() string {
+	style := lipgloss.NewStyle().Align(lipgloss.Center).Width(s.width - 10)
+	return style.Render(s.body(s.cfg))
+}
+
+func (s *InfoScene) Title() string { return s.title }
+
+// CapturesKeys is always false: the Info scene has no text input.
+func (s *InfoScene) CapturesKeys() bool { return false }
+
+func (s *InfoScene) Keys() []key.Binding {
+	return []key.Binding{backKey, quitKey}
+}
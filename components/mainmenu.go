@@ -0,0 +1,106 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/config"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+// MainMenu is the root scene: a list of the portfolio's sections.
+type MainMenu struct {
+	cfg      *config.Config
+	theme    theme.Theme
+	opener   OpenURLFunc
+	sections []string
+	cursor   int
+	width    int
+	height   int
+}
+
+// NewMainMenu builds the main menu scene. opener is threaded down into
+// the Projects scene, which is the only section that opens links.
+func NewMainMenu(cfg *config.Config, t theme.Theme, opener OpenURLFunc) *MainMenu {
+	return &MainMenu{
+		cfg:      cfg,
+		theme:    t,
+		opener:   opener,
+		sections: []string{"About Me", "Education", "Experience", "Skills", "Projects", "Contact", "Exit"},
+	}
+}
+
+func (m *MainMenu) Init() tea.Cmd { return nil }
+
+func (m *MainMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case configReloadedMsg:
+		m.cfg = msg.cfg
+	case themeChangedMsg:
+		m.theme = msg.theme
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.sections)-1 {
+				m.cursor++
+			}
+		case "enter":
+			return m, m.selectCmd()
+		}
+	}
+	return m, nil
+}
+
+func (m *MainMenu) selectCmd() tea.Cmd {
+	switch m.sections[m.cursor] {
+	case "Exit":
+		return tea.Quit
+	case "About Me":
+		return PushScene(NewAboutScene(m.cfg, m.theme))
+	case "Education":
+		return PushScene(NewEducationScene(m.cfg, m.theme))
+	case "Experience":
+		return PushScene(NewExperienceScene(m.cfg, m.theme))
+	case "Skills":
+		return PushScene(NewSkillsScene(m.cfg, m.theme))
+	case "Projects":
+		return PushScene(NewProjectsScene(m.theme, m.opener))
+	case "Contact":
+		return PushScene(NewContactScene(m.cfg, m.theme))
+	}
+	return nil
+}
+
+func (m *MainMenu) View() string {
+	menuStyle := lipgloss.NewStyle().Align(lipgloss.Center).Width(m.width - 4)
+	menuContent := ""
+	for i, section := range m.sections {
+		if i == m.cursor {
+			menuContent += lipgloss.NewStyle().Foreground(m.theme.HighlightColor).Render(" > "+section) + "\n"
+		} else {
+			menuContent += "   " + section + "\n"
+		}
+	}
+	return menuStyle.Render(menuContent)
+}
+
+func (m *MainMenu) Title() string { return "Main Menu" }
+
+// CapturesKeys is always false: the Main Menu has no text input.
+func (m *MainMenu) CapturesKeys() bool { return false }
+
+func (m *MainMenu) Keys() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		quitKey,
+	}
+}
@@ -0,0 +1,30 @@
+package components
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// osc52OpenURL returns an OpenURLFunc that copies url to the connecting
+// client's clipboard instead of opening a local browser. It tries the
+// host's own clipboard via atotto/clipboard first (handy when testing
+// the SSH server locally), then always writes an OSC52 escape sequence
+// to w, which is what actually reaches the terminal's clipboard over a
+// real SSH connection.
+func osc52OpenURL(w io.Writer) OpenURLFunc {
+	return func(url string) tea.Cmd {
+		return func() tea.Msg {
+			_ = clipboard.WriteAll(url)
+
+			encoded := base64.StdEncoding.EncodeToString([]byte(url))
+			if _, err := fmt.Fprintf(w, "\x1b]52;c;%s\x07", encoded); err != nil {
+				return errMsg{err: err}
+			}
+			return openURLMsg(url)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+// ProjectInfoScene shows a repo's README rendered with Glamour in a
+// scrollable viewport.
+type ProjectInfoScene struct {
+	repoFullName string
+	repoURL      string
+	owner, repo  string
+
+	viewport viewport.Model
+	spinner  spinner.Model
+	loading  bool
+	errMsg   string
+	theme    theme.Theme
+	opener   OpenURLFunc
+	width    int
+	height   int
+}
+
+// NewProjectInfoScene builds the scene for owner/repo. Call Init to
+// start fetching its README.
+func NewProjectInfoScene(fullName, url, owner, repo string, t theme.Theme, opener OpenURLFunc) *ProjectInfoScene {
+	vp := viewport.New(maxWidth, 20)
+	return &ProjectInfoScene{
+		repoFullName: fullName,
+		repoURL:      url,
+		owner:        owner,
+		repo:         repo,
+		viewport:     vp,
+		spinner:      spinner.New(spinner.WithSpinner(spinner.Dot)),
+		loading:      true,
+		theme:        t,
+		opener:       opener,
+	}
+}
+
+func (s *ProjectInfoScene) Init() tea.Cmd {
+	return tea.Batch(s.spinner.Tick, fetchReadme(s.owner, s.repo))
+}
+
+// halfPage mirrors the Skills table's "move by half a screen" feel for
+// the README viewport.
+func halfPage(vp viewport.Model) int {
+	h := vp.Height / 2
+	if h < 1 {
+		h = 1
+	}
+	return h
+}
+
+func (s *ProjectInfoScene) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		s.height = msg.Height
+		s.viewport.Width = msg.Width - 10
+		s.viewport.Height = msg.Height - 12
+		return s, nil
+	case readmeMsg:
+		s.loading = false
+		s.viewport.SetContent(msg.rendered)
+		s.viewport.GotoTop()
+		return s, nil
+	case errMsg:
+		s.loading = false
+		s.errMsg = msg.err.Error()
+		return s, nil
+	case themeChangedMsg:
+		s.theme = msg.theme
+		return s, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "b":
+			return s, PopScene()
+		case "o":
+			return s, s.opener(s.repoURL)
+		case "u":
+			s.viewport.LineUp(halfPage(s.viewport))
+			return s, nil
+		case "d":
+			s.viewport.LineDown(halfPage(s.viewport))
+			return s, nil
+		case "g":
+			s.viewport.GotoTop()
+			return s, nil
+		case "G":
+			s.viewport.GotoBottom()
+			return s, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	if s.loading {
+		s.spinner, cmd = s.spinner.Update(msg)
+		return s, cmd
+	}
+	s.viewport, cmd = s.viewport.Update(msg)
+	return s, cmd
+}
+
+func (s *ProjectInfoScene) View() string {
+	if s.errMsg != "" {
+		return lipgloss.NewStyle().Foreground(s.theme.ErrorColor).
+			Render("Could not load README for " + s.repoFullName + ":\n" + s.errMsg)
+	}
+	if s.loading {
+		return lipgloss.NewStyle().Align(lipgloss.Center).Width(s.width - 10).
+			Render("\n" + s.spinner.View() + " Loading README for " + s.repoFullName + "...")
+	}
+	return strings.TrimRight(s.viewport.View(), "\n")
+}
+
+func (s *ProjectInfoScene) Title() string { return s.repoFullName }
+
+// CapturesKeys is always false: the README viewport has no text input.
+func (s *ProjectInfoScene) CapturesKeys() bool { return false }
+
+func (s *ProjectInfoScene) Keys() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("u"), key.WithHelp("u", "half page up")),
+		key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "half page down")),
+		key.NewBinding(key.WithKeys("g", "G"), key.WithHelp("g/G", "top/bottom")),
+		key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "open in browser")),
+		backKey,
+		quitKey,
+	}
+}
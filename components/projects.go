@@ -0,0 +1,279 @@
+package components
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+var appStyle = lipgloss.NewStyle().Padding(1, 2).Align(lipgloss.Center)
+
+const maxWidth = 9999
+
+// repoSortMode is a sort order for the Projects list, cycled with "s".
+type repoSortMode int
+
+const (
+	sortByUpdated repoSortMode = iota
+	sortByStars
+	sortByName
+	sortModeCount
+)
+
+func (m repoSortMode) String() string {
+	switch m {
+	case sortByStars:
+		return "stars"
+	case sortByName:
+		return "name"
+	default:
+		return "updated"
+	}
+}
+
+type repoItem struct {
+	title       string
+	description string
+	url         string
+	fullName    string
+	language    string
+	topics      []string
+}
+
+// FilterValue feeds bubbles/list's built-in sahilm/fuzzy filtering,
+// searching across the repo's name, description, language and topics.
+func (i repoItem) FilterValue() string {
+	return strings.Join(append([]string{i.title, i.description, i.language}, i.topics...), " ")
+}
+func (i repoItem) Title() string       { return i.title }
+func (i repoItem) Description() string { return i.description }
+
+// repoDelegate renders a repo with its description on the second line.
+type repoDelegate struct{ theme theme.Theme }
+
+func (d repoDelegate) Height() int                             { return 2 }
+func (d repoDelegate) Spacing() int                            { return 1 }
+func (d repoDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+func (d repoDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	i, ok := listItem.(repoItem)
+	if !ok {
+		return
+	}
+
+	titleStr := fmt.Sprintf("%d. %s", index+1, i.title)
+	if index == m.Index() {
+		fmt.Fprint(w, d.theme.SelectedItemStyle.Render("> "+titleStr))
+	} else {
+		fmt.Fprint(w, d.theme.ItemStyle.Render(titleStr))
+	}
+	fmt.Fprint(w, "\n")
+
+	desc := i.description
+	if desc == "" {
+		desc = "No description"
+	}
+	fmt.Fprint(w, d.theme.DescriptionStyle.Render(desc))
+}
+
+// ProjectsScene lists mhommet's GitHub repositories; pressing Enter on
+// one pushes a ProjectInfoScene with its rendered README.
+type ProjectsScene struct {
+	list    list.Model
+	spinner spinner.Model
+	loading bool
+	errMsg  string
+	theme   theme.Theme
+	opener  OpenURLFunc
+	width   int
+	height  int
+
+	allRepos     []githubRepo
+	sortMode     repoSortMode
+	hideForks    bool
+	hideArchived bool
+}
+
+// NewProjectsScene builds the Projects scene. Call Init to start the
+// GitHub fetch. opener is threaded into the ProjectInfoScene pushed when
+// the user presses Enter, which is where "o" actually opens a link.
+func NewProjectsScene(t theme.Theme, opener OpenURLFunc) *ProjectsScene {
+	l := list.New([]list.Item{}, repoDelegate{theme: t}, maxWidth, 20)
+	l.SetShowTitle(true)
+	l.Title = "GitHub Projects"
+	l.Styles.Title = t.ListTitleStyle
+	l.SetShowPagination(false)
+	l.SetShowStatusBar(false)
+	l.SetShowHelp(false)
+	l.SetFilteringEnabled(true)
+
+	return &ProjectsScene{
+		list:    l,
+		spinner: spinner.New(spinner.WithSpinner(spinner.Dot)),
+		loading: true,
+		theme:   t,
+		opener:  opener,
+	}
+}
+
+func (s *ProjectsScene) Init() tea.Cmd {
+	return tea.Batch(s.spinner.Tick, fetchGitHubRepos())
+}
+
+// rebuildItems re-applies the hide-forks/hide-archived filters and the
+// current sort mode to allRepos and pushes the result into the list. It
+// returns list.Model.SetItems's tea.Cmd, which callers must run: when a
+// filter is already applied, SetItems clears filteredItems and relies on
+// that cmd to recompute them, so dropping it leaves VisibleItems/
+// SelectedItem returning nothing until the filter is re-entered.
+func (s *ProjectsScene) rebuildItems() tea.Cmd {
+	visible := make([]githubRepo, 0, len(s.allRepos))
+	for _, repo := range s.allRepos {
+		if s.hideForks && repo.Fork {
+			continue
+		}
+		if s.hideArchived && repo.Archived {
+			continue
+		}
+		visible = append(visible, repo)
+	}
+
+	sort.SliceStable(visible, func(i, j int) bool {
+		switch s.sortMode {
+		case sortByStars:
+			return visible[i].StargazersCount > visible[j].StargazersCount
+		case sortByName:
+			return strings.ToLower(visible[i].Name) < strings.ToLower(visible[j].Name)
+		default:
+			ti, _ := time.Parse(time.RFC3339, visible[i].UpdatedAt)
+			tj, _ := time.Parse(time.RFC3339, visible[j].UpdatedAt)
+			return ti.After(tj)
+		}
+	})
+
+	items := make([]list.Item, 0, len(visible))
+	for _, repo := range visible {
+		items = append(items, repoItem{
+			title:       repo.Name,
+			description: repo.Description,
+			url:         repo.HTMLURL,
+			fullName:    repo.FullName,
+			language:    repo.Language,
+			topics:      repo.Topics,
+		})
+	}
+	return s.list.SetItems(items)
+}
+
+func (s *ProjectsScene) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		s.height = msg.Height
+		h, v := appStyle.GetFrameSize()
+		s.list.SetSize(msg.Width-h, msg.Height-v-10)
+		return s, nil
+	case repoMsg:
+		s.allRepos = msg.repos
+		s.loading = false
+		cmd := s.rebuildItems()
+
+		status := "Press Enter to read the selected project's README"
+		if msg.fromCache {
+			status = "Loaded from cache • " + status
+		}
+		return s, tea.Batch(cmd, s.list.NewStatusMessage(s.theme.StatusMessageStyle(status)))
+	case errMsg:
+		s.loading = false
+		s.errMsg = msg.err.Error()
+		return s, nil
+	case themeChangedMsg:
+		s.theme = msg.theme
+		s.list.SetDelegate(repoDelegate{theme: s.theme})
+		s.list.Styles.Title = s.theme.ListTitleStyle
+		return s, nil
+	case tea.KeyMsg:
+		if s.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "b":
+				return s, PopScene()
+			case "enter":
+				if s.loading || len(s.list.VisibleItems()) == 0 {
+					return s, nil
+				}
+				selected, ok := s.list.SelectedItem().(repoItem)
+				if !ok {
+					return s, nil
+				}
+				owner, repo, ok := splitFullName(selected.fullName)
+				if !ok {
+					return s, nil
+				}
+				return s, PushScene(NewProjectInfoScene(selected.fullName, selected.url, owner, repo, s.theme, s.opener))
+			case "s":
+				s.sortMode = (s.sortMode + 1) % sortModeCount
+				cmd := s.rebuildItems()
+				return s, tea.Batch(cmd, s.list.NewStatusMessage(s.theme.StatusMessageStyle("Sorted by "+s.sortMode.String())))
+			case "f":
+				s.hideForks = !s.hideForks
+				return s, s.rebuildItems()
+			case "a":
+				s.hideArchived = !s.hideArchived
+				return s, s.rebuildItems()
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	if s.loading {
+		s.spinner, cmd = s.spinner.Update(msg)
+		return s, cmd
+	}
+	s.list, cmd = s.list.Update(msg)
+	return s, cmd
+}
+
+func (s *ProjectsScene) View() string {
+	if s.errMsg != "" {
+		return fmt.Sprintf("Error: %s\n", s.errMsg)
+	}
+	if s.loading {
+		return lipgloss.NewStyle().Align(lipgloss.Center).Width(s.width - 10).
+			Render("\n" + s.spinner.View() + " Loading projects...")
+	}
+
+	content := s.list.View()
+	content += "\n\nPress Enter to read the selected project's README."
+	return content
+}
+
+func (s *ProjectsScene) Title() string { return "Projects" }
+
+// CapturesKeys is true while the list's fuzzy filter input is active, so
+// RootModel lets keystrokes like "q" and "t" through to the filter query
+// instead of quitting or cycling the theme.
+func (s *ProjectsScene) CapturesKeys() bool {
+	return s.list.FilterState() == list.Filtering
+}
+
+func (s *ProjectsScene) Keys() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open README")),
+		key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+		key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "cycle sort")),
+		key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "toggle forks")),
+		key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "toggle archived")),
+		backKey,
+		quitKey,
+	}
+}
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+func TestProjectsSceneRebuildItems(t *testing.T) {
+	repos := []githubRepo{
+		{Name: "zeta", StargazersCount: 1, UpdatedAt: "2023-01-01T00:00:00Z"},
+		{Name: "alpha", StargazersCount: 10, UpdatedAt: "2024-06-01T00:00:00Z"},
+		{Name: "beta", StargazersCount: 5, UpdatedAt: "2024-01-01T00:00:00Z", Fork: true},
+		{Name: "gamma", StargazersCount: 3, UpdatedAt: "2022-01-01T00:00:00Z", Archived: true},
+	}
+
+	tests := []struct {
+		name         string
+		sortMode     repoSortMode
+		hideForks    bool
+		hideArchived bool
+		want         []string
+	}{
+		{
+			name:     "sort by updated, nothing hidden",
+			sortMode: sortByUpdated,
+			want:     []string{"alpha", "beta", "zeta", "gamma"},
+		},
+		{
+			name:     "sort by stars, nothing hidden",
+			sortMode: sortByStars,
+			want:     []string{"alpha", "beta", "gamma", "zeta"},
+		},
+		{
+			name:     "sort by name, nothing hidden",
+			sortMode: sortByName,
+			want:     []string{"alpha", "beta", "gamma", "zeta"},
+		},
+		{
+			name:      "hide forks",
+			sortMode:  sortByName,
+			hideForks: true,
+			want:      []string{"alpha", "gamma", "zeta"},
+		},
+		{
+			name:         "hide archived",
+			sortMode:     sortByName,
+			hideArchived: true,
+			want:         []string{"alpha", "beta", "zeta"},
+		},
+		{
+			name:         "hide forks and archived",
+			sortMode:     sortByName,
+			hideForks:    true,
+			hideArchived: true,
+			want:         []string{"alpha", "zeta"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewProjectsScene(theme.Default(), nil)
+			s.allRepos = repos
+			s.sortMode = tt.sortMode
+			s.hideForks = tt.hideForks
+			s.hideArchived = tt.hideArchived
+			s.rebuildItems()
+
+			items := s.list.Items()
+			if len(items) != len(tt.want) {
+				t.Fatalf("got %d items, want %d: %v", len(items), len(tt.want), items)
+			}
+			for i, item := range items {
+				got := item.(repoItem).title
+				if got != tt.want[i] {
+					t.Errorf("item %d: got %q, want %q", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
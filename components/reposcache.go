@@ -0,0 +1,61 @@
+package components
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// repoCache is the on-disk shape of $XDG_CACHE_HOME/cli_portfolio/repos.json.
+// Storing GitHub's ETag alongside the last known repos lets re-launches
+// be instant (a 304 means the cached list is still valid) and lets the
+// Projects scene work offline if the request fails outright.
+type repoCache struct {
+	ETag  string       `json:"etag"`
+	Repos []githubRepo `json:"repos"`
+}
+
+func repoCachePath() (string, error) {
+	dir := os.Getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(dir, "cli_portfolio", "repos.json"), nil
+}
+
+// loadRepoCache reads the cached repo list, if any. A missing cache is
+// not an error: it just means this is the first run.
+func loadRepoCache() *repoCache {
+	path, err := repoCachePath()
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var cache repoCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil
+	}
+	return &cache
+}
+
+func saveRepoCache(cache repoCache) error {
+	path, err := repoCachePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,330 @@
+// Package components implements the portfolio's Bubble Tea scenes: a
+// RootModel owns shared state (size, stopwatch, config, theme) and a
+// stack of Scenes, each a self-contained tea.Model for one page of the
+// portfolio. Adding a new page is a matter of writing a new Scene and
+// wiring it into MainMenu.selectCmd, instead of growing one giant page
+// switch.
+package components
+
+import (
+	"io"
+	"log"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/stopwatch"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/config"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+const nameArt = `
+  __  __ _ _             _   _                          _
+ |  \/  (_) |           | | | |                        | |
+ | \  / |_| | __ _ _ __ | |_| | ___  _ __ ___  _ __ ___| |_
+ | |\/| | | |/ _` + "`" + ` | '_ \| __| |/ _ \| '_ ` + "`" + ` _ \| '_ ` + "`" + ` _ \ __|
+ | |  | | | | (_| | | | | |_| | (_) | | | | | | | | | | |_
+ |_|  |_|_|_|\__,_|_| |_|\__|_|\___/|_| |_| |_|_| |_| |_|\__|
+`
+
+type tickMsg time.Time
+
+// RootModel owns everything shared across scenes and the navigation
+// stack. It is the single tea.Model handed to tea.NewProgram.
+type RootModel struct {
+	width, height int
+	stopwatch     stopwatch.Model
+	help          help.Model
+	theme         theme.Theme
+
+	cfg        *config.Config
+	cfgPath    string
+	cfgReloads chan configReloadedMsg
+
+	stack []Scene
+
+	showSplash  bool
+	splashTimer int
+}
+
+// NewRootModel loads the portfolio config and theme, and builds the
+// initial scene stack (just the main menu, behind the splash screen).
+// The "o" key opens links in a local browser.
+func NewRootModel() RootModel {
+	return newRootModel(openURL)
+}
+
+// NewRootModelForSSH is like NewRootModel, except the "o" key copies
+// links to the connecting client's clipboard via OSC52 (written to w)
+// instead of opening a local browser, which does not exist on the
+// server. cmd/cli_portfolio_ssh builds one fresh per session.
+func NewRootModelForSSH(w io.Writer) RootModel {
+	return newRootModel(osc52OpenURL(w))
+}
+
+func newRootModel(opener OpenURLFunc) RootModel {
+	cfgPath, err := config.DefaultPath()
+	if err != nil {
+		log.Printf("config: %v", err)
+	}
+	cfg, err := config.Load(cfgPath)
+
+	selectedTheme, ok := theme.LoadSelected()
+	if !ok {
+		selectedTheme = theme.Detect()
+	}
+
+	stack := []Scene{}
+	if err != nil {
+		log.Printf("config: %v", err)
+		cfg = config.Default()
+		stack = append(stack, NewMainMenu(cfg, selectedTheme, opener), NewErrorScene("Could not load "+config.FileName+": "+err.Error(), selectedTheme))
+	} else {
+		stack = append(stack, NewMainMenu(cfg, selectedTheme, opener))
+	}
+
+	return RootModel{
+		help:        help.New(),
+		theme:       selectedTheme,
+		cfg:         cfg,
+		cfgPath:     cfgPath,
+		cfgReloads:  make(chan configReloadedMsg),
+		stack:       stack,
+		showSplash:  true,
+		splashTimer: 20,
+		stopwatch:   stopwatch.NewWithInterval(time.Second),
+	}
+}
+
+func (m RootModel) current() Scene {
+	return m.stack[len(m.stack)-1]
+}
+
+// broadcast runs msg through every scene on the stack, keeping each
+// scene's own mutations (used for messages like window resizes or theme
+// changes that every page needs to react to, not just the visible one).
+func (m RootModel) broadcast(msg tea.Msg) {
+	for i, scene := range m.stack {
+		updated, _ := scene.Update(msg)
+		m.stack[i] = updated.(Scene)
+	}
+}
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(time.Millisecond*50, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+func (m RootModel) Init() tea.Cmd {
+	go watchConfig(m.cfgPath, m.cfgReloads)
+
+	return tea.Batch(
+		tickCmd(),
+		m.stopwatch.Init(),
+		waitForConfigReload(m.cfgReloads),
+		m.current().Init(),
+	)
+}
+
+func (m RootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var swCmd tea.Cmd
+	m.stopwatch, swCmd = m.stopwatch.Update(msg)
+
+	if m.showSplash {
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			m.showSplash = false
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			m.broadcast(msg)
+			return m, nil
+		case tickMsg:
+			m.splashTimer--
+			if m.splashTimer <= 0 {
+				m.showSplash = false
+				return m, nil
+			}
+			return m, tickCmd()
+		}
+		return m, swCmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if !m.current().CapturesKeys() {
+			switch msg.String() {
+			case "q":
+				return m, tea.Quit
+			case "t":
+				m.theme = theme.Next(m.theme)
+				if err := theme.Save(m.theme); err != nil {
+					log.Printf("theme: %v", err)
+				}
+				m.broadcast(themeChangedMsg{theme: m.theme})
+				return m, nil
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case configReloadedMsg:
+		m.cfg = msg.cfg
+		_, cmd := m.current().Update(msg)
+		return m, tea.Batch(cmd, waitForConfigReload(m.cfgReloads))
+	case pushSceneMsg:
+		m.stack = append(m.stack, msg.scene)
+		msg.scene.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		return m, msg.scene.Init()
+	case popSceneMsg:
+		if len(m.stack) <= 1 {
+			return m, tea.Quit
+		}
+		m.stack = m.stack[:len(m.stack)-1]
+		return m, nil
+	}
+
+	updated, cmd := m.current().Update(msg)
+	m.stack[len(m.stack)-1] = updated.(Scene)
+	return m, tea.Batch(cmd, swCmd)
+}
+
+func (m RootModel) View() string {
+	if m.showSplash {
+		return m.splashView()
+	}
+
+	header := m.headerView()
+	footer := m.footerView()
+
+	mainStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height - 4).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	innerStyle := lipgloss.NewStyle().Width(m.width - 4).Align(lipgloss.Center)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.theme.HighlightColor).
+		Align(lipgloss.Center).
+		Width(m.width - 4)
+
+	scene := m.current()
+	inner := titleStyle.Render("Welcome to my portfolio") + "\n\n"
+	inner += scene.View()
+	inner += "\n\n" + m.helpView(scene)
+
+	return header + "\n" + mainStyle.Render(innerStyle.Render(inner)) + "\n" + footer
+}
+
+func (m RootModel) helpView(scene Scene) string {
+	style := lipgloss.NewStyle().Foreground(m.theme.MutedColor).Align(lipgloss.Center).Width(m.width - 4)
+	keys := append(append([]key.Binding{}, scene.Keys()...), themeKey)
+	return style.Render(m.help.ShortHelpView(keys))
+}
+
+func (m RootModel) splashView() string {
+	mainStyle := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	containerStyle := lipgloss.NewStyle().
+		Width(80).
+		Align(lipgloss.Center).
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(m.theme.SplashBorderColor).
+		Padding(1, 2)
+
+	nameStyle := lipgloss.NewStyle().
+		Foreground(m.theme.SplashNameColor).
+		Align(lipgloss.Center).
+		Width(76)
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.theme.SplashTitleColor).
+		Align(lipgloss.Center).
+		Width(76).
+		MarginTop(1)
+
+	artBox := nameStyle.Render(nameArt)
+	title := titleStyle.Render(m.cfg.Name + " - " + m.cfg.Title)
+
+	content := artBox + "\n" + title
+	return mainStyle.Render(containerStyle.Render(content))
+}
+
+func (m RootModel) headerView() string {
+	headerContainerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		BorderBottom(true).
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.HeaderBorderColor)
+
+	nameStyle := lipgloss.NewStyle().
+		Foreground(m.theme.NameColor).
+		Bold(true).
+		PaddingLeft(4).
+		Width(30)
+
+	jobStyle := lipgloss.NewStyle().
+		Foreground(m.theme.JobColor).
+		Bold(true).
+		PaddingRight(4).
+		Align(lipgloss.Right).
+		Width(30)
+
+	leftContent := nameStyle.Render(m.cfg.Name)
+	rightContent := jobStyle.Render(m.cfg.Title)
+
+	return headerContainerStyle.Render(
+		lipgloss.JoinHorizontal(
+			lipgloss.Center,
+			leftContent,
+			lipgloss.NewStyle().
+				Width(m.width-lipgloss.Width(leftContent)-lipgloss.Width(rightContent)).
+				Render(""),
+			rightContent,
+		),
+	)
+}
+
+func (m RootModel) footerView() string {
+	footerStyle := lipgloss.NewStyle().
+		Width(m.width).
+		BorderTop(true).
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(m.theme.FooterBorderColor)
+
+	clockText := lipgloss.NewStyle().
+		Foreground(m.theme.MutedColor).
+		PaddingLeft(4).
+		Width(20).
+		Render("🕒 " + time.Now().Format("15:04:05"))
+
+	timerText := lipgloss.NewStyle().
+		Foreground(m.theme.MutedColor).
+		Align(lipgloss.Right).
+		PaddingRight(4).
+		Width(20).
+		Render("⏱ " + m.stopwatch.View())
+
+	return footerStyle.Render(
+		lipgloss.JoinHorizontal(
+			lipgloss.Center,
+			clockText,
+			lipgloss.NewStyle().
+				Width(m.width-lipgloss.Width(clockText)-lipgloss.Width(timerText)).
+				Render(""),
+			timerText,
+		),
+	)
+}
@@ -0,0 +1,58 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Scene is a single screen of the portfolio. It is a regular Bubble Tea
+// model that additionally advertises its own key bindings so RootModel
+// can render contextual help at the bottom of the screen.
+type Scene interface {
+	tea.Model
+	Title() string
+	Keys() []key.Binding
+
+	// CapturesKeys reports whether the scene is currently in a text-input
+	// state (e.g. the Projects list filter) where every keystroke,
+	// including "q" and "t", must reach the scene verbatim instead of
+	// being intercepted by RootModel's global bindings.
+	CapturesKeys() bool
+}
+
+// pushSceneMsg asks RootModel to push a new scene on top of the stack.
+type pushSceneMsg struct{ scene Scene }
+
+// popSceneMsg asks RootModel to pop the current scene and return to the
+// one below it. Popping the last scene on the stack quits the program.
+type popSceneMsg struct{}
+
+// PushScene returns a command that navigates forward to scene.
+func PushScene(scene Scene) tea.Cmd {
+	return func() tea.Msg { return pushSceneMsg{scene: scene} }
+}
+
+// PopScene returns a command that navigates back to the previous scene.
+func PopScene() tea.Cmd {
+	return func() tea.Msg { return popSceneMsg{} }
+}
+
+// backKey is the binding every scene but the main menu exposes to return
+// to the previous one.
+var backKey = key.NewBinding(
+	key.WithKeys("b"),
+	key.WithHelp("b", "back"),
+)
+
+// quitKey is the binding every scene exposes to exit the program.
+var quitKey = key.NewBinding(
+	key.WithKeys("q"),
+	key.WithHelp("q", "quit"),
+)
+
+// themeKey is the global binding, handled by RootModel, that cycles the
+// active Theme. It is shown in every scene's help bar.
+var themeKey = key.NewBinding(
+	key.WithKeys("t"),
+	key.WithHelp("t", "cycle theme"),
+)
@@ -0,0 +1,116 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mhommet/cli_portfolio/internal/config"
+	"github.com/mhommet/cli_portfolio/internal/theme"
+)
+
+// SkillsScene renders the skills table from the config.
+type SkillsScene struct {
+	cfg    *config.Config
+	table  table.Model
+	theme  theme.Theme
+	width  int
+	height int
+}
+
+// NewSkillsScene builds the Skills scene from the current config.
+func NewSkillsScene(cfg *config.Config, t theme.Theme) *SkillsScene {
+	return &SkillsScene{cfg: cfg, table: buildSkillsTable(cfg, t), theme: t}
+}
+
+func buildSkillsTable(cfg *config.Config, t theme.Theme) table.Model {
+	columns := []table.Column{
+		{Title: "Category", Width: 25},
+		{Title: "Skills", Width: 50},
+	}
+
+	rows := make([]table.Row, 0, len(cfg.Skills))
+	for _, skill := range cfg.Skills {
+		rows = append(rows, table.Row{skill.Category, skill.Skills})
+	}
+
+	tbl := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(10),
+	)
+
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(t.TableHeaderColor).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(t.TableSelectedFg).
+		Background(t.TableSelectedBg).
+		Bold(false)
+	tbl.SetStyles(s)
+
+	return tbl
+}
+
+func (s *SkillsScene) Init() tea.Cmd { return nil }
+
+func (s *SkillsScene) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width = msg.Width
+		s.height = msg.Height
+		s.table.SetWidth(msg.Width - 20)
+		s.table.SetHeight(msg.Height - 15)
+		return s, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "b":
+			return s, PopScene()
+		case "up", "k":
+			s.table.MoveUp(1)
+			return s, nil
+		case "down", "j":
+			s.table.MoveDown(1)
+			return s, nil
+		}
+	case configReloadedMsg:
+		s.cfg = msg.cfg
+		s.table = buildSkillsTable(s.cfg, s.theme)
+		s.table.SetWidth(s.width - 20)
+		s.table.SetHeight(s.height - 15)
+		return s, nil
+	case themeChangedMsg:
+		s.theme = msg.theme
+		s.table = buildSkillsTable(s.cfg, s.theme)
+		s.table.SetWidth(s.width - 20)
+		s.table.SetHeight(s.height - 15)
+		return s, nil
+	}
+
+	var cmd tea.Cmd
+	s.table, cmd = s.table.Update(msg)
+	return s, cmd
+}
+
+func (s *SkillsScene) View() string {
+	style := lipgloss.NewStyle().Align(lipgloss.Center).Width(s.width - 10)
+	return style.Render("\nSkills:\n\n" + s.theme.TableBorderStyle.Render(s.table.View()))
+}
+
+func (s *SkillsScene) Title() string { return "Skills" }
+
+// CapturesKeys is always false: the Skills table has no text input.
+func (s *SkillsScene) CapturesKeys() bool { return false }
+
+func (s *SkillsScene) Keys() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		backKey,
+		quitKey,
+	}
+}
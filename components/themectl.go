@@ -0,0 +1,7 @@
+package components
+
+import "github.com/mhommet/cli_portfolio/internal/theme"
+
+// themeChangedMsg is broadcast to every scene on the stack whenever the
+// user cycles the active theme with "t".
+type themeChangedMsg struct{ theme theme.Theme }
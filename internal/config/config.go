@@ -0,0 +1,114 @@
+// Package config loads the portfolio's content (About Me, Education,
+// Experience, Skills, Contact) from a YAML file so the TUI can be
+// repurposed for someone else's portfolio without touching Go code.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kardianos/osext"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the config file cli_portfolio looks for next to its binary.
+const FileName = "portfolio.yaml"
+
+// EducationItem is a single line in the Education section.
+type EducationItem struct {
+	Years  string `yaml:"years"`
+	Degree string `yaml:"degree"`
+}
+
+// ExperienceItem is a single line in the Experience section.
+type ExperienceItem struct {
+	Years string `yaml:"years"`
+	Role  string `yaml:"role"`
+}
+
+// SkillCategory is a row of the Skills table.
+type SkillCategory struct {
+	Category string `yaml:"category"`
+	Skills   string `yaml:"skills"`
+}
+
+// Contact holds the ways to reach the portfolio's owner.
+type Contact struct {
+	Email    string `yaml:"email"`
+	LinkedIn string `yaml:"linkedin"`
+}
+
+// Config is the full set of content rendered by the TUI.
+type Config struct {
+	Name       string           `yaml:"name"`
+	Title      string           `yaml:"title"`
+	About      []string         `yaml:"about"`
+	Education  []EducationItem  `yaml:"education"`
+	Experience []ExperienceItem `yaml:"experience"`
+	Skills     []SkillCategory  `yaml:"skills"`
+	Contact    Contact          `yaml:"contact"`
+}
+
+// Default returns the content that used to be hardcoded in main.go, used
+// as a fallback when no portfolio.yaml can be found or parsed.
+func Default() *Config {
+	return &Config{
+		Name:  "Milan Hommet",
+		Title: "Fullstack Developer",
+		About: []string{
+			"I'm a software developer based in France, specializing in software and mobile development but I'm also interested in game development.",
+			"I'm currently pursuing an MBA in development and management. I like to learn new languages and frameworks in my free time.",
+			"I have a work-study contract at Téïcée as a backend developer.",
+		},
+		Education: []EducationItem{
+			{Years: "2023 - 2025", Degree: "Master degree - Fullstack developer"},
+			{Years: "2022 - 2023", Degree: "Bachelor degree - Web developer"},
+			{Years: "2020 - 2022", Degree: "BTEC Higher National Diploma - web and software development"},
+		},
+		Experience: []ExperienceItem{
+			{Years: "2022 - today", Role: "Fullstack Developer at Téïcée"},
+		},
+		Skills: []SkillCategory{
+			{Category: "Programming Languages", Skills: "Python, JavaScript, TypeScript, Dart, PHP"},
+			{Category: "Mobile Development", Skills: "Flutter, React Native"},
+			{Category: "Software Development", Skills: "Electron"},
+			{Category: "Web Development", Skills: "React, Symfony, VueJS, NextJS, NodeJS"},
+			{Category: "Databases", Skills: "MySQL, MongoDB, Microsoft SQL Server"},
+			{Category: "Game Engine", Skills: "Unity"},
+		},
+		Contact: Contact{
+			Email:    "milan.hommet@protonmail.com",
+			LinkedIn: "https://www.linkedin.com/in/milan-hommet-840414315/",
+		},
+	}
+}
+
+// DefaultPath returns the path of portfolio.yaml next to the running
+// binary, following symlinks so a `go run` shim doesn't hide it.
+func DefaultPath() (string, error) {
+	dir, err := osext.ExecutableFolder()
+	if err != nil {
+		return "", fmt.Errorf("locate executable folder: %w", err)
+	}
+	return filepath.Join(dir, FileName), nil
+}
+
+// Load reads and parses the config file at path. If the file does not
+// exist, Default is returned instead so the app still has something to
+// show on a fresh checkout.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Default(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	cfg := Default()
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+	return cfg, nil
+}
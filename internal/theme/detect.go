@@ -0,0 +1,13 @@
+package theme
+
+import "github.com/muesli/termenv"
+
+// Detect picks a sensible starting theme for the current terminal: the
+// default dark palette, unless termenv reports a light background, in
+// which case Solarized Light reads better out of the box.
+func Detect() Theme {
+	if termenv.HasDarkBackground() {
+		return Default()
+	}
+	return SolarizedLight()
+}
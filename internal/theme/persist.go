@@ -0,0 +1,50 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// statePath returns the small file cli_portfolio remembers the user's
+// chosen theme in across launches.
+func statePath() (string, error) {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "cli_portfolio", "theme"), nil
+}
+
+// LoadSelected returns the last persisted theme choice, if any.
+func LoadSelected() (Theme, bool) {
+	path, err := statePath()
+	if err != nil {
+		return Theme{}, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, false
+	}
+	name := strings.TrimSpace(string(data))
+	if name == "" {
+		return Theme{}, false
+	}
+	return ByName(name), true
+}
+
+// Save persists t as the theme to load on the next launch.
+func Save(t Theme) error {
+	path, err := statePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(t.Name), 0o644)
+}
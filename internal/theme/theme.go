@@ -0,0 +1,240 @@
+// Package theme centralizes every lipgloss style used by the portfolio
+// into a single swappable Theme, so the whole TUI can be reskinned (or
+// adapted to a light/dark terminal) without touching any scene code.
+package theme
+
+import "github.com/charmbracelet/lipgloss"
+
+// Theme is the full set of colors and styles the portfolio renders
+// with. Scenes read styles off the Theme they're given instead of
+// declaring their own lipgloss.Style package-level vars.
+type Theme struct {
+	Name string
+
+	// List (Projects)
+	ItemStyle          lipgloss.Style
+	SelectedItemStyle  lipgloss.Style
+	DescriptionStyle   lipgloss.Style
+	ListTitleStyle     lipgloss.Style
+	StatusMessageStyle func(...string) string
+
+	// Table (Skills)
+	TableBorderStyle lipgloss.Style
+	TableHeaderColor lipgloss.Color
+	TableSelectedFg  lipgloss.Color
+	TableSelectedBg  lipgloss.Color
+
+	// Header / footer chrome
+	HeaderBorderColor lipgloss.Color
+	FooterBorderColor lipgloss.Color
+	NameColor         lipgloss.Color
+	JobColor          lipgloss.Color
+	MutedColor        lipgloss.Color
+
+	// Splash screen
+	SplashBorderColor lipgloss.Color
+	SplashNameColor   lipgloss.Color
+	SplashTitleColor  lipgloss.Color
+
+	// Misc
+	HighlightColor lipgloss.Color
+	ErrorColor     lipgloss.Color
+}
+
+// Default is the purple/green palette the portfolio originally shipped with.
+func Default() Theme {
+	return build("default", palette{
+		highlight:   "170",
+		border:      "#8A2BE2",
+		name:        "#4287f5",
+		job:         "#ff66c4",
+		muted:       "#888888",
+		listTitleFg: "#FFFDF5",
+		listTitleBg: "#25A065",
+		desc:        "#A49FA5",
+		status:      "#04B575",
+		error:       "203",
+		tableHeader: "240",
+		tableFg:     "229",
+		tableBg:     "57",
+	})
+}
+
+// Dracula is the well-known Dracula color scheme.
+func Dracula() Theme {
+	return build("dracula", palette{
+		highlight:   "141",
+		border:      "#BD93F9",
+		name:        "#8BE9FD",
+		job:         "#FF79C6",
+		muted:       "#6272A4",
+		listTitleFg: "#282A36",
+		listTitleBg: "#50FA7B",
+		desc:        "#F8F8F2",
+		status:      "#50FA7B",
+		error:       "#FF5555",
+		tableHeader: "#6272A4",
+		tableFg:     "#282A36",
+		tableBg:     "#FF79C6",
+	})
+}
+
+// SolarizedDark is Ethan Schoonover's Solarized palette, dark variant.
+func SolarizedDark() Theme {
+	return build("solarized-dark", palette{
+		highlight:   "#B58900",
+		border:      "#268BD2",
+		name:        "#2AA198",
+		job:         "#CB4B16",
+		muted:       "#586E75",
+		listTitleFg: "#FDF6E3",
+		listTitleBg: "#268BD2",
+		desc:        "#93A1A1",
+		status:      "#859900",
+		error:       "#DC322F",
+		tableHeader: "#586E75",
+		tableFg:     "#FDF6E3",
+		tableBg:     "#268BD2",
+	})
+}
+
+// SolarizedLight is Solarized's light variant, picked automatically on
+// light-background terminals by Detect.
+func SolarizedLight() Theme {
+	return build("solarized-light", palette{
+		highlight:   "#B58900",
+		border:      "#268BD2",
+		name:        "#2AA198",
+		job:         "#CB4B16",
+		muted:       "#839496",
+		listTitleFg: "#002B36",
+		listTitleBg: "#93A1A1",
+		desc:        "#657B83",
+		status:      "#859900",
+		error:       "#DC322F",
+		tableHeader: "#93A1A1",
+		tableFg:     "#002B36",
+		tableBg:     "#EEE8D5",
+	})
+}
+
+// Gruvbox is the Gruvbox dark palette.
+func Gruvbox() Theme {
+	return build("gruvbox", palette{
+		highlight:   "#FABD2F",
+		border:      "#FE8019",
+		name:        "#83A598",
+		job:         "#FB4934",
+		muted:       "#928374",
+		listTitleFg: "#282828",
+		listTitleBg: "#B8BB26",
+		desc:        "#EBDBB2",
+		status:      "#B8BB26",
+		error:       "#FB4934",
+		tableHeader: "#928374",
+		tableFg:     "#282828",
+		tableBg:     "#FABD2F",
+	})
+}
+
+// Monochrome is a high-contrast, colorless theme for accessibility.
+func Monochrome() Theme {
+	return build("monochrome", palette{
+		highlight:   "255",
+		border:      "255",
+		name:        "255",
+		job:         "255",
+		muted:       "250",
+		listTitleFg: "0",
+		listTitleBg: "255",
+		desc:        "255",
+		status:      "255",
+		error:       "255",
+		tableHeader: "255",
+		tableFg:     "0",
+		tableBg:     "255",
+	})
+}
+
+// Presets lists every built-in theme, in the order "t" cycles through.
+var Presets = []Theme{
+	Default(),
+	Dracula(),
+	SolarizedDark(),
+	SolarizedLight(),
+	Gruvbox(),
+	Monochrome(),
+}
+
+// Next returns the preset after current in Presets, wrapping around.
+func Next(current Theme) Theme {
+	for i, t := range Presets {
+		if t.Name == current.Name {
+			return Presets[(i+1)%len(Presets)]
+		}
+	}
+	return Presets[0]
+}
+
+// ByName returns the preset with the given name, or Default if unknown.
+func ByName(name string) Theme {
+	for _, t := range Presets {
+		if t.Name == name {
+			return t
+		}
+	}
+	return Default()
+}
+
+// palette is the small set of raw colors each preset is built from; build
+// turns it into the full Theme of lipgloss styles.
+type palette struct {
+	highlight   lipgloss.Color
+	border      lipgloss.Color
+	name        lipgloss.Color
+	job         lipgloss.Color
+	muted       lipgloss.Color
+	listTitleFg lipgloss.Color
+	listTitleBg lipgloss.Color
+	desc        lipgloss.Color
+	status      lipgloss.Color
+	error       lipgloss.Color
+	tableHeader lipgloss.Color
+	tableFg     lipgloss.Color
+	tableBg     lipgloss.Color
+}
+
+func build(name string, p palette) Theme {
+	return Theme{
+		Name: name,
+
+		ItemStyle:         lipgloss.NewStyle().PaddingLeft(4),
+		SelectedItemStyle: lipgloss.NewStyle().PaddingLeft(2).Foreground(p.highlight),
+		DescriptionStyle:  lipgloss.NewStyle().PaddingLeft(4).Foreground(p.desc),
+		ListTitleStyle: lipgloss.NewStyle().
+			Foreground(p.listTitleFg).
+			Background(p.listTitleBg).
+			Padding(0, 1),
+		StatusMessageStyle: lipgloss.NewStyle().Foreground(p.status).Render,
+
+		TableBorderStyle: lipgloss.NewStyle().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(p.tableHeader),
+		TableHeaderColor: p.tableHeader,
+		TableSelectedFg:  p.tableFg,
+		TableSelectedBg:  p.tableBg,
+
+		HeaderBorderColor: p.border,
+		FooterBorderColor: p.border,
+		NameColor:         p.name,
+		JobColor:          p.job,
+		MutedColor:        p.muted,
+
+		SplashBorderColor: p.border,
+		SplashNameColor:   p.name,
+		SplashTitleColor:  p.job,
+
+		HighlightColor: p.highlight,
+		ErrorColor:     p.error,
+	}
+}